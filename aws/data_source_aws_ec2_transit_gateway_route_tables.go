@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsEc2TransitGatewayRouteTables() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2TransitGatewayRouteTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2TransitGatewayRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewayRouteTablesInput{}
+
+	input.Filters = append(input.Filters, buildEc2CustomFilterList(d.Get("filter").(*schema.Set))...)
+	input.Filters = append(input.Filters, buildEc2TagFilterList(
+		keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Ec2Tags(),
+	)...)
+
+	if v, ok := d.GetOk("transit_gateway_id"); ok {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("transit-gateway-id"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	var routeTableIDs []string
+
+	for {
+		output, err := conn.DescribeTransitGatewayRouteTables(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Transit Gateway Route Tables: %s", err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, routeTable := range output.TransitGatewayRouteTables {
+			if routeTable == nil {
+				continue
+			}
+
+			routeTableIDs = append(routeTableIDs, aws.StringValue(routeTable.TransitGatewayRouteTableId))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("ids", routeTableIDs); err != nil {
+		return fmt.Errorf("error setting ids: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSEc2TransitGatewayRouteTablesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTablesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					// The transit gateway's default route table plus the test route table.
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2TransitGatewayRouteTablesDataSourceConfig() string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "aws_ec2_transit_gateway_route_tables" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  depends_on = [aws_ec2_transit_gateway_route_table.test]
+}
+`, testAccAWSEc2TransitGatewayRouteTableConfig())
+}
@@ -3,10 +3,13 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
@@ -18,8 +21,15 @@ func resourceAwsEc2TransitGatewayRouteTable() *schema.Resource {
 		Read:   resourceAwsEc2TransitGatewayRouteTableRead,
 		Update: resourceAwsEc2TransitGatewayRouteTableUpdate,
 		Delete: resourceAwsEc2TransitGatewayRouteTableDelete,
+		Exists: resourceAwsEc2TransitGatewayRouteTableExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsEc2TransitGatewayRouteTableImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +37,21 @@ func resourceAwsEc2TransitGatewayRouteTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"association": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Configuration block for associations managed inline. Conflicts with the standalone aws_ec2_transit_gateway_route_table_association resource. Omit this block to manage associations outside of this resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transit_gateway_attachment_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
 			"default_association_route_table": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -35,6 +60,45 @@ func resourceAwsEc2TransitGatewayRouteTable() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"propagation": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Configuration block for propagations managed inline. Conflicts with the standalone aws_ec2_transit_gateway_route_table_propagation resource. Omit this block to manage propagations outside of this resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transit_gateway_attachment_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"route": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Configuration block for static routes managed inline. Conflicts with the standalone aws_ec2_transit_gateway_route resource. Omit this block to manage routes outside of this resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blackhole": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"transit_gateway_attachment_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"tags": tagsSchema(),
 			"transit_gateway_id": {
 				Type:         schema.TypeString,
@@ -46,6 +110,55 @@ func resourceAwsEc2TransitGatewayRouteTable() *schema.Resource {
 	}
 }
 
+// resourceAwsEc2TransitGatewayRouteTableImport accepts either a bare
+// Transit Gateway Route Table ID or a "transit-gateway-id/transit-gateway-route-table-id"
+// pair, the latter allowing drift-reconciliation of an existing route table
+// (and, via Read, its inline routes/associations/propagations) without
+// having to look up its Transit Gateway ID out of band.
+func resourceAwsEc2TransitGatewayRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	switch len(parts) {
+	case 1:
+		d.SetId(parts[0])
+	case 2:
+		if parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%s), expected TRANSIT-GATEWAY-ID/TRANSIT-GATEWAY-ROUTE-TABLE-ID or TRANSIT-GATEWAY-ROUTE-TABLE-ID", d.Id())
+		}
+
+		d.Set("transit_gateway_id", parts[0])
+		d.SetId(parts[1])
+	default:
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected TRANSIT-GATEWAY-ID/TRANSIT-GATEWAY-ROUTE-TABLE-ID or TRANSIT-GATEWAY-ROUTE-TABLE-ID", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsEc2TransitGatewayRouteTableExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	transitGatewayRouteTable, err := ec2DescribeTransitGatewayRouteTable(conn, d.Id())
+
+	if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("error reading EC2 Transit Gateway Route Table: %s", err)
+	}
+
+	if transitGatewayRouteTable == nil {
+		return false, nil
+	}
+
+	if aws.StringValue(transitGatewayRouteTable.State) == ec2.TransitGatewayRouteTableStateDeleting || aws.StringValue(transitGatewayRouteTable.State) == ec2.TransitGatewayRouteTableStateDeleted {
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func resourceAwsEc2TransitGatewayRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -62,10 +175,22 @@ func resourceAwsEc2TransitGatewayRouteTableCreate(d *schema.ResourceData, meta i
 
 	d.SetId(aws.StringValue(output.TransitGatewayRouteTable.TransitGatewayRouteTableId))
 
-	if err := waitForEc2TransitGatewayRouteTableCreation(conn, d.Id()); err != nil {
+	if err := waitForEc2TransitGatewayRouteTableAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) availability: %s", d.Id(), err)
 	}
 
+	if err := ec2TransitGatewayRouteTableAssociationsUpdate(conn, d.Id(), nil, d.Get("association").(*schema.Set), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error associating EC2 Transit Gateway Route Table (%s): %s", d.Id(), err)
+	}
+
+	if err := ec2TransitGatewayRouteTablePropagationsUpdate(conn, d.Id(), nil, d.Get("propagation").(*schema.Set), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error enabling EC2 Transit Gateway Route Table (%s) propagations: %s", d.Id(), err)
+	}
+
+	if err := ec2TransitGatewayRouteTableRoutesUpdate(conn, d.Id(), nil, d.Get("route").(*schema.Set)); err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Route Table (%s) routes: %s", d.Id(), err)
+	}
+
 	return resourceAwsEc2TransitGatewayRouteTableRead(d, meta)
 }
 
@@ -97,9 +222,36 @@ func resourceAwsEc2TransitGatewayRouteTableRead(d *schema.ResourceData, meta int
 		return nil
 	}
 
+	associations, err := ec2TransitGatewayRouteTableAssociationsRead(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) associations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("association", associations); err != nil {
+		return fmt.Errorf("error setting association: %s", err)
+	}
+
 	d.Set("default_association_route_table", aws.BoolValue(transitGatewayRouteTable.DefaultAssociationRouteTable))
 	d.Set("default_propagation_route_table", aws.BoolValue(transitGatewayRouteTable.DefaultPropagationRouteTable))
 
+	propagations, err := ec2TransitGatewayRouteTablePropagationsRead(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) propagations: %s", d.Id(), err)
+	}
+
+	if err := d.Set("propagation", propagations); err != nil {
+		return fmt.Errorf("error setting propagation: %s", err)
+	}
+
+	routes, err := ec2TransitGatewayRouteTableRoutesRead(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Route Table (%s) routes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("route", routes); err != nil {
+		return fmt.Errorf("error setting route: %s", err)
+	}
+
 	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(transitGatewayRouteTable.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
@@ -122,6 +274,30 @@ func resourceAwsEc2TransitGatewayRouteTableRead(d *schema.ResourceData, meta int
 func resourceAwsEc2TransitGatewayRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	if d.HasChange("association") {
+		o, n := d.GetChange("association")
+
+		if err := ec2TransitGatewayRouteTableAssociationsUpdate(conn, d.Id(), o.(*schema.Set), n.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Route Table (%s) associations: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("propagation") {
+		o, n := d.GetChange("propagation")
+
+		if err := ec2TransitGatewayRouteTablePropagationsUpdate(conn, d.Id(), o.(*schema.Set), n.(*schema.Set), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Route Table (%s) propagations: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+
+		if err := ec2TransitGatewayRouteTableRoutesUpdate(conn, d.Id(), o.(*schema.Set), n.(*schema.Set)); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Route Table (%s) routes: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		o, n := d.GetChange("tags")
 
@@ -130,7 +306,7 @@ func resourceAwsEc2TransitGatewayRouteTableUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	return nil
+	return resourceAwsEc2TransitGatewayRouteTableRead(d, meta)
 }
 
 func resourceAwsEc2TransitGatewayRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
@@ -151,9 +327,561 @@ func resourceAwsEc2TransitGatewayRouteTableDelete(d *schema.ResourceData, meta i
 		return fmt.Errorf("error deleting EC2 Transit Gateway Route Table: %s", err)
 	}
 
-	if err := waitForEc2TransitGatewayRouteTableDeletion(conn, d.Id()); err != nil {
+	if err := waitForEc2TransitGatewayRouteTableDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("error waiting for EC2 Transit Gateway Route Table (%s) deletion: %s", d.Id(), err)
 	}
 
 	return nil
 }
+
+// ec2TransitGatewayRouteTableStateRefreshFunc tracks the route table's own
+// lifecycle state, treating a NotFound error as the "deleted" target so the
+// deletion waiter below can converge on it directly.
+func ec2TransitGatewayRouteTableStateRefreshFunc(conn *ec2.EC2, transitGatewayRouteTableID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayRouteTable, err := ec2DescribeTransitGatewayRouteTable(conn, transitGatewayRouteTableID)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			return "", ec2.TransitGatewayRouteTableStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if transitGatewayRouteTable == nil {
+			return "", ec2.TransitGatewayRouteTableStateDeleted, nil
+		}
+
+		return transitGatewayRouteTable, aws.StringValue(transitGatewayRouteTable.State), nil
+	}
+}
+
+func waitForEc2TransitGatewayRouteTableAvailable(conn *ec2.EC2, transitGatewayRouteTableID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableStatePending},
+		Target:  []string{ec2.TransitGatewayRouteTableStateAvailable},
+		Refresh: ec2TransitGatewayRouteTableStateRefreshFunc(conn, transitGatewayRouteTableID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableDeleted(conn *ec2.EC2, transitGatewayRouteTableID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableStateAvailable, ec2.TransitGatewayRouteTableStateDeleting},
+		Target:  []string{ec2.TransitGatewayRouteTableStateDeleted},
+		Refresh: ec2TransitGatewayRouteTableStateRefreshFunc(conn, transitGatewayRouteTableID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// ec2TransitGatewayRouteTableRoutesRead returns the flattened static routes
+// (active or blackholed) currently attached to the given route table, for use
+// as the computed mirror of the "route" configuration block.
+func ec2TransitGatewayRouteTableRoutesRead(conn *ec2.EC2, transitGatewayRouteTableID string) ([]interface{}, error) {
+	routes, err := ec2TransitGatewayRouteTableStaticRoutes(conn, transitGatewayRouteTableID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []interface{}
+
+	for _, route := range routes {
+		m := map[string]interface{}{
+			"blackhole":              aws.StringValue(route.State) == ec2.TransitGatewayRouteStateBlackhole,
+			"destination_cidr_block": aws.StringValue(route.DestinationCidrBlock),
+		}
+
+		if len(route.TransitGatewayAttachments) > 0 && route.TransitGatewayAttachments[0] != nil {
+			m["transit_gateway_attachment_id"] = aws.StringValue(route.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+		}
+
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+// ec2TransitGatewayRouteTableStaticRoutes lists the static (non-propagated)
+// routes in the active or blackhole state for the given route table, using a
+// single SearchTransitGatewayRoutes call; routes beyond that call's
+// MaxResults are not returned.
+func ec2TransitGatewayRouteTableStaticRoutes(conn *ec2.EC2, transitGatewayRouteTableID string) ([]*ec2.TransitGatewayRoute, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayRouteStateActive, ec2.TransitGatewayRouteStateBlackhole}),
+			},
+			{
+				Name:   aws.String("type"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayRouteTypeStatic}),
+			},
+		},
+		// SearchTransitGatewayRoutes has no NextToken/cursor: MaxResults only
+		// caps a single call, and AdditionalRoutesAvailable just reports
+		// whether that cap was hit, so request the API maximum up front.
+		MaxResults:                 aws.Int64(1000),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	log.Printf("[DEBUG] Searching EC2 Transit Gateway Route Table (%s) routes: %s", transitGatewayRouteTableID, input)
+	output, err := conn.SearchTransitGatewayRoutes(input)
+	if err != nil {
+		return nil, fmt.Errorf("error searching EC2 Transit Gateway Route Table (%s) routes: %s", transitGatewayRouteTableID, err)
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	if aws.BoolValue(output.AdditionalRoutesAvailable) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) has more than %d static routes; only the first %d are managed by this resource", transitGatewayRouteTableID, len(output.Routes), len(output.Routes))
+	}
+
+	return output.Routes, nil
+}
+
+// ec2TransitGatewayRouteTableRoutesUpdate diffs the configured "route" set
+// against the current static routes on the route table and issues the
+// Create/Replace/Delete calls necessary to converge.
+func ec2TransitGatewayRouteTableRoutesUpdate(conn *ec2.EC2, transitGatewayRouteTableID string, o, n *schema.Set) error {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	existingRoutes, err := ec2TransitGatewayRouteTableStaticRoutes(conn, transitGatewayRouteTableID)
+	if err != nil {
+		return err
+	}
+
+	existingCIDRs := make(map[string]bool, len(existingRoutes))
+	for _, route := range existingRoutes {
+		existingCIDRs[aws.StringValue(route.DestinationCidrBlock)] = true
+	}
+
+	newCIDRs := make(map[string]bool, n.Len())
+	for _, vRoute := range n.List() {
+		if mRoute, ok := vRoute.(map[string]interface{}); ok {
+			newCIDRs[mRoute["destination_cidr_block"].(string)] = true
+		}
+	}
+
+	for _, vRoute := range o.Difference(n).List() {
+		mRoute, ok := vRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		destinationCidrBlock := mRoute["destination_cidr_block"].(string)
+
+		// A route for this destination is still present in the new
+		// configuration (only its attachment or blackhole setting changed),
+		// so it is replaced atomically below rather than deleted here.
+		if newCIDRs[destinationCidrBlock] {
+			continue
+		}
+
+		input := &ec2.DeleteTransitGatewayRouteInput{
+			DestinationCidrBlock:       aws.String(destinationCidrBlock),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route: %s", input)
+		_, err := conn.DeleteTransitGatewayRoute(input)
+
+		if isAWSErr(err, "InvalidRoute.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting EC2 Transit Gateway Route (%s): %s", destinationCidrBlock, err)
+		}
+	}
+
+	for _, vRoute := range n.Difference(o).List() {
+		mRoute, ok := vRoute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		destinationCidrBlock := mRoute["destination_cidr_block"].(string)
+		blackhole := mRoute["blackhole"].(bool)
+		transitGatewayAttachmentID := mRoute["transit_gateway_attachment_id"].(string)
+
+		if existingCIDRs[destinationCidrBlock] {
+			input := &ec2.ReplaceTransitGatewayRouteInput{
+				Blackhole:                  aws.Bool(blackhole),
+				DestinationCidrBlock:       aws.String(destinationCidrBlock),
+				TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+			}
+
+			if transitGatewayAttachmentID != "" {
+				input.TransitGatewayAttachmentId = aws.String(transitGatewayAttachmentID)
+			}
+
+			log.Printf("[DEBUG] Replacing EC2 Transit Gateway Route: %s", input)
+			if _, err := conn.ReplaceTransitGatewayRoute(input); err != nil {
+				return fmt.Errorf("error replacing EC2 Transit Gateway Route (%s): %s", destinationCidrBlock, err)
+			}
+
+			continue
+		}
+
+		input := &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(blackhole),
+			DestinationCidrBlock:       aws.String(destinationCidrBlock),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		if transitGatewayAttachmentID != "" {
+			input.TransitGatewayAttachmentId = aws.String(transitGatewayAttachmentID)
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Transit Gateway Route: %s", input)
+		if _, err := conn.CreateTransitGatewayRoute(input); err != nil {
+			return fmt.Errorf("error creating EC2 Transit Gateway Route (%s): %s", destinationCidrBlock, err)
+		}
+	}
+
+	return nil
+}
+
+// ec2TransitGatewayRouteTableAssociationsRead returns the flattened
+// associations currently attached to the given route table.
+func ec2TransitGatewayRouteTableAssociationsRead(conn *ec2.EC2, transitGatewayRouteTableID string) ([]interface{}, error) {
+	input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayAssociationStateAssociated}),
+			},
+		},
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	var result []interface{}
+
+	for {
+		log.Printf("[DEBUG] Listing EC2 Transit Gateway Route Table (%s) associations: %s", transitGatewayRouteTableID, input)
+		output, err := conn.GetTransitGatewayRouteTableAssociations(input)
+		if err != nil {
+			return nil, fmt.Errorf("error listing EC2 Transit Gateway Route Table (%s) associations: %s", transitGatewayRouteTableID, err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, association := range output.Associations {
+			if association == nil {
+				continue
+			}
+
+			result = append(result, map[string]interface{}{
+				"transit_gateway_attachment_id": aws.StringValue(association.TransitGatewayAttachmentId),
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
+// ec2TransitGatewayRouteTableAssociationsUpdate diffs the configured
+// "association" set against the current associations and issues the
+// Associate/Disassociate calls necessary to converge, waiting on each state
+// transition.
+func ec2TransitGatewayRouteTableAssociationsUpdate(conn *ec2.EC2, transitGatewayRouteTableID string, o, n *schema.Set, timeout time.Duration) error {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	for _, vAssociation := range o.Difference(n).List() {
+		mAssociation, ok := vAssociation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transitGatewayAttachmentID := mAssociation["transit_gateway_attachment_id"].(string)
+
+		input := &ec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		log.Printf("[DEBUG] Disassociating EC2 Transit Gateway Route Table: %s", input)
+		_, err := conn.DisassociateTransitGatewayRouteTable(input)
+
+		if isAWSErr(err, "InvalidAssociation.NotFound", "") || isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error disassociating EC2 Transit Gateway Attachment (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		if err := waitForEc2TransitGatewayRouteTableAssociationDeletion(conn, transitGatewayRouteTableID, transitGatewayAttachmentID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway Attachment (%s) disassociation: %s", transitGatewayAttachmentID, err)
+		}
+	}
+
+	for _, vAssociation := range n.Difference(o).List() {
+		mAssociation, ok := vAssociation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transitGatewayAttachmentID := mAssociation["transit_gateway_attachment_id"].(string)
+
+		input := &ec2.AssociateTransitGatewayRouteTableInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		log.Printf("[DEBUG] Associating EC2 Transit Gateway Route Table: %s", input)
+		if _, err := conn.AssociateTransitGatewayRouteTable(input); err != nil {
+			return fmt.Errorf("error associating EC2 Transit Gateway Attachment (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		if err := waitForEc2TransitGatewayRouteTableAssociationCreation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway Attachment (%s) association: %s", transitGatewayAttachmentID, err)
+		}
+	}
+
+	return nil
+}
+
+// ec2TransitGatewayRouteTablePropagationsRead returns the flattened
+// propagations currently enabled on the given route table.
+func ec2TransitGatewayRouteTablePropagationsRead(conn *ec2.EC2, transitGatewayRouteTableID string) ([]interface{}, error) {
+	input := &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("state"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayPropagationStateEnabled}),
+			},
+		},
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	var result []interface{}
+
+	for {
+		log.Printf("[DEBUG] Listing EC2 Transit Gateway Route Table (%s) propagations: %s", transitGatewayRouteTableID, input)
+		output, err := conn.GetTransitGatewayRouteTablePropagations(input)
+		if err != nil {
+			return nil, fmt.Errorf("error listing EC2 Transit Gateway Route Table (%s) propagations: %s", transitGatewayRouteTableID, err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, propagation := range output.TransitGatewayRouteTablePropagations {
+			if propagation == nil {
+				continue
+			}
+
+			result = append(result, map[string]interface{}{
+				"transit_gateway_attachment_id": aws.StringValue(propagation.TransitGatewayAttachmentId),
+			})
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
+// ec2TransitGatewayRouteTablePropagationsUpdate diffs the configured
+// "propagation" set against the current propagations and issues the
+// Enable/Disable calls necessary to converge, waiting on each state
+// transition.
+func ec2TransitGatewayRouteTablePropagationsUpdate(conn *ec2.EC2, transitGatewayRouteTableID string, o, n *schema.Set, timeout time.Duration) error {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	for _, vPropagation := range o.Difference(n).List() {
+		mPropagation, ok := vPropagation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transitGatewayAttachmentID := mPropagation["transit_gateway_attachment_id"].(string)
+
+		input := &ec2.DisableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		log.Printf("[DEBUG] Disabling EC2 Transit Gateway Route Table propagation: %s", input)
+		_, err := conn.DisableTransitGatewayRouteTablePropagation(input)
+
+		if isAWSErr(err, "InvalidAssociation.NotFound", "") || isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error disabling EC2 Transit Gateway Attachment (%s) propagation: %s", transitGatewayAttachmentID, err)
+		}
+
+		if err := waitForEc2TransitGatewayRouteTablePropagationDeletion(conn, transitGatewayRouteTableID, transitGatewayAttachmentID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway Attachment (%s) propagation disablement: %s", transitGatewayAttachmentID, err)
+		}
+	}
+
+	for _, vPropagation := range n.Difference(o).List() {
+		mPropagation, ok := vPropagation.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		transitGatewayAttachmentID := mPropagation["transit_gateway_attachment_id"].(string)
+
+		input := &ec2.EnableTransitGatewayRouteTablePropagationInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		log.Printf("[DEBUG] Enabling EC2 Transit Gateway Route Table propagation: %s", input)
+		if _, err := conn.EnableTransitGatewayRouteTablePropagation(input); err != nil {
+			return fmt.Errorf("error enabling EC2 Transit Gateway Attachment (%s) propagation: %s", transitGatewayAttachmentID, err)
+		}
+
+		if err := waitForEc2TransitGatewayRouteTablePropagationCreation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID, timeout); err != nil {
+			return fmt.Errorf("error waiting for EC2 Transit Gateway Attachment (%s) propagation enablement: %s", transitGatewayAttachmentID, err)
+		}
+	}
+
+	return nil
+}
+
+func ec2TransitGatewayRouteTableAssociationStateRefreshFunc(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("transit-gateway-attachment-id"),
+					Values: aws.StringSlice([]string{transitGatewayAttachmentID}),
+				},
+			},
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		output, err := conn.GetTransitGatewayRouteTableAssociations(input)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.Associations) == 0 || output.Associations[0] == nil {
+			return "", ec2.TransitGatewayAssociationStateDisassociated, nil
+		}
+
+		return output.Associations[0], aws.StringValue(output.Associations[0].State), nil
+	}
+}
+
+func waitForEc2TransitGatewayRouteTableAssociationCreation(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAssociationStateAssociating},
+		Target:  []string{ec2.TransitGatewayAssociationStateAssociated},
+		Refresh: ec2TransitGatewayRouteTableAssociationStateRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTableAssociationDeletion(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAssociationStateAssociated, ec2.TransitGatewayAssociationStateDisassociating},
+		Target:  []string{ec2.TransitGatewayAssociationStateDisassociated},
+		Refresh: ec2TransitGatewayRouteTableAssociationStateRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func ec2TransitGatewayRouteTablePropagationStateRefreshFunc(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ec2.GetTransitGatewayRouteTablePropagationsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("transit-gateway-attachment-id"),
+					Values: aws.StringSlice([]string{transitGatewayAttachmentID}),
+				},
+			},
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		output, err := conn.GetTransitGatewayRouteTablePropagations(input)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.TransitGatewayRouteTablePropagations) == 0 || output.TransitGatewayRouteTablePropagations[0] == nil {
+			return "", ec2.TransitGatewayPropagationStateDisabled, nil
+		}
+
+		return output.TransitGatewayRouteTablePropagations[0], aws.StringValue(output.TransitGatewayRouteTablePropagations[0].State), nil
+	}
+}
+
+func waitForEc2TransitGatewayRouteTablePropagationCreation(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPropagationStateEnabling},
+		Target:  []string{ec2.TransitGatewayPropagationStateEnabled},
+		Refresh: ec2TransitGatewayRouteTablePropagationStateRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2TransitGatewayRouteTablePropagationDeletion(conn *ec2.EC2, transitGatewayRouteTableID string, transitGatewayAttachmentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayPropagationStateEnabled, ec2.TransitGatewayPropagationStateDisabling},
+		Target:  []string{ec2.TransitGatewayPropagationStateDisabled},
+		Refresh: ec2TransitGatewayRouteTablePropagationStateRefreshFunc(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
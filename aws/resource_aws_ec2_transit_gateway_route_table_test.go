@@ -0,0 +1,304 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSEc2TransitGatewayRouteTable_Route(t *testing.T) {
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	vpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfigRoute("10.0.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", vpcAttachmentResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfigRoute("10.0.1.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayRouteTable_ImportTransitGatewayIDAndRouteTableID(t *testing.T) {
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[transitGatewayResourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", transitGatewayResourceName)
+					}
+
+					return fmt.Sprintf("%s/%s", rs.Primary.ID, aws.StringValue(transitGatewayRouteTable1.TransitGatewayRouteTableId)), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayRouteTable_AssociationAndPropagation(t *testing.T) {
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	vpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfigAssociationAndPropagation(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagation.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "association.*.transit_gateway_attachment_id", vpcAttachmentResourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "propagation.*.transit_gateway_attachment_id", vpcAttachmentResourceName, "id"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "propagation.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayRouteTable_disappears(t *testing.T) {
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsEc2TransitGatewayRouteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TransitGatewayRouteTable_Timeouts(t *testing.T) {
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TransitGateway(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TransitGatewayRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TransitGatewayRouteTableConfigTimeouts(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName, &transitGatewayRouteTable1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteTableExists(resourceName string, transitGatewayRouteTable *ec2.TransitGatewayRouteTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		transitGatewayRouteTableOutput, err := ec2DescribeTransitGatewayRouteTable(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if transitGatewayRouteTableOutput == nil {
+			return fmt.Errorf("EC2 Transit Gateway Route Table not found")
+		}
+
+		*transitGatewayRouteTable = *transitGatewayRouteTableOutput
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TransitGatewayRouteTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_route_table" {
+			continue
+		}
+
+		transitGatewayRouteTable, err := ec2DescribeTransitGatewayRouteTable(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidRouteTableID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if transitGatewayRouteTable == nil {
+			continue
+		}
+
+		if aws.StringValue(transitGatewayRouteTable.State) != ec2.TransitGatewayRouteTableStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Route Table (%s) still exists, state: %s", rs.Primary.ID, aws.StringValue(transitGatewayRouteTable.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEc2TransitGatewayRouteTableConfig() string {
+	return `
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+`
+}
+
+func testAccAWSEc2TransitGatewayRouteTableConfigTimeouts() string {
+	return `
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  timeouts {
+    create = "20m"
+    update = "20m"
+    delete = "20m"
+  }
+}
+`
+}
+
+func testAccAWSEc2TransitGatewayRouteTableConfigRoute(destinationCidrBlock string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  route {
+    destination_cidr_block        = %[1]q
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+`, destinationCidrBlock)
+}
+
+func testAccAWSEc2TransitGatewayRouteTableConfigAssociationAndPropagation() string {
+	return `
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  association {
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  propagation {
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+`
+}